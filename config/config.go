@@ -0,0 +1,136 @@
+// Package config centralizes the environment-driven settings the rest of the
+// application depends on, so no other package needs to call os.Getenv directly.
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/joho/godotenv"
+)
+
+// Config holds every environment-derived setting used across the app.
+type Config struct {
+	Port string
+
+	DBDriver      string
+	DBHost        string
+	DBPort        string
+	DBUser        string
+	DBPass        string
+	DBName        string
+	DBSSLMode     string
+	DBAutoMigrate bool
+
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+
+	JWTPrivateKey string
+	JWTPublicKey  string
+
+	AppBaseURL               string
+	RequireEmailVerification bool
+
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+
+	OAuthStateSecret string
+
+	GoogleClientID     string
+	GoogleClientSecret string
+	GoogleRedirectURL  string
+
+	GithubClientID     string
+	GithubClientSecret string
+	GithubRedirectURL  string
+}
+
+// App is the process-wide Config populated by the most recent call to Load.
+var App *Config
+
+// Load reads the .env file (if present) and environment variables into a Config.
+func Load() *Config {
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	redisDB, err := strconv.Atoi(os.Getenv("REDIS_DB"))
+	if err != nil {
+		redisDB = 0
+	}
+
+	dbDriver := getEnv("DB_DRIVER", "sqlite")
+	autoMigrate, ok := boolEnv("DB_AUTO_MIGRATE")
+	if !ok {
+		// Default to on for sqlite so a fresh checkout with no env vars set
+		// works out of the box; other drivers are expected to migrate
+		// explicitly via cmd/migrate instead of auto-migrating in prod.
+		autoMigrate = dbDriver == "sqlite"
+	}
+	requireVerification, _ := strconv.ParseBool(os.Getenv("REQUIRE_EMAIL_VERIFICATION"))
+
+	cfg := &Config{
+		Port: getEnv("PORT", "3000"),
+
+		DBDriver:      dbDriver,
+		DBHost:        os.Getenv("DB_HOST"),
+		DBPort:        os.Getenv("DB_PORT"),
+		DBUser:        os.Getenv("DB_USER"),
+		DBPass:        os.Getenv("DB_PASS"),
+		DBName:        getEnv("DB_NAME", "users.db"),
+		DBSSLMode:     getEnv("DB_SSLMODE", "disable"),
+		DBAutoMigrate: autoMigrate,
+
+		RedisAddr:     getEnv("REDIS_ADDR", "localhost:6379"),
+		RedisPassword: os.Getenv("REDIS_PASSWORD"),
+		RedisDB:       redisDB,
+
+		JWTPrivateKey: os.Getenv("JWT_PRIVATE_KEY"),
+		JWTPublicKey:  os.Getenv("JWT_PUBLIC_KEY"),
+
+		AppBaseURL:               getEnv("APP_BASE_URL", "http://localhost:3000"),
+		RequireEmailVerification: requireVerification,
+
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     getEnv("SMTP_PORT", "587"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:     getEnv("SMTP_FROM", "no-reply@go-fiber-auth.local"),
+
+		OAuthStateSecret: os.Getenv("OAUTH_STATE_SECRET"),
+
+		GoogleClientID:     os.Getenv("GOOGLE_CLIENT_ID"),
+		GoogleClientSecret: os.Getenv("GOOGLE_CLIENT_SECRET"),
+		GoogleRedirectURL:  os.Getenv("GOOGLE_REDIRECT_URL"),
+
+		GithubClientID:     os.Getenv("GITHUB_CLIENT_ID"),
+		GithubClientSecret: os.Getenv("GITHUB_CLIENT_SECRET"),
+		GithubRedirectURL:  os.Getenv("GITHUB_REDIRECT_URL"),
+	}
+
+	App = cfg
+	return cfg
+}
+
+func getEnv(key, fallback string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return fallback
+}
+
+// boolEnv parses key as a bool, reporting ok=false when it's unset or not a
+// valid bool so callers can fall back to a context-specific default instead
+// of silently treating "unset" the same as "false".
+func boolEnv(key string) (value bool, ok bool) {
+	v, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return false, false
+	}
+	return v, true
+}
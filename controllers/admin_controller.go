@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golu7059/Go-Fiber-auth/database"
+	"github.com/golu7059/Go-Fiber-auth/models"
+	"github.com/golu7059/Go-Fiber-auth/utils"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ListUsers handles GET /admin/users?page=&limit=&search=
+func ListUsers(c *fiber.Ctx) error {
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", strconv.Itoa(defaultPageSize)))
+	if err != nil || limit < 1 || limit > maxPageSize {
+		limit = defaultPageSize
+	}
+
+	query := database.DB.Model(&models.User{})
+	if search := c.Query("search"); search != "" {
+		like := "%" + search + "%"
+		query = query.Where("name LIKE ? OR email LIKE ?", like, like)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to count users",
+		})
+	}
+
+	var users []models.User
+	if err := query.Order("id").Offset((page - 1) * limit).Limit(limit).Find(&users).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to list users",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"users": users,
+		"page":  page,
+		"limit": limit,
+		"total": total,
+	})
+}
+
+// GetUser handles GET /admin/users/:id
+func GetUser(c *fiber.Ctx) error {
+	user := new(models.User)
+	if err := database.DB.First(user, c.Params("id")).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+	return c.JSON(user)
+}
+
+// UpdateUser handles PATCH /admin/users/:id, letting an admin change a
+// user's role or disable their account. Either change invalidates that
+// user's existing refresh tokens so it takes effect immediately.
+func UpdateUser(c *fiber.Ctx) error {
+	user := new(models.User)
+	if err := database.DB.First(user, c.Params("id")).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+
+	input := struct {
+		Role     *string `json:"role" validate:"omitempty,oneof=user admin"`
+		Disabled *bool   `json:"disabled"`
+	}{}
+	if err := c.BodyParser(&input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body",
+		})
+	}
+	if fieldErrors := utils.ValidateRequest(input); fieldErrors != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"errors": fieldErrors,
+		})
+	}
+
+	revokeSessions := (input.Role != nil && *input.Role != user.Role) ||
+		(input.Disabled != nil && *input.Disabled && !user.Disabled)
+
+	updates := map[string]any{}
+	if input.Role != nil {
+		updates["role"] = *input.Role
+	}
+	if input.Disabled != nil {
+		updates["disabled"] = *input.Disabled
+	}
+	if len(updates) > 0 {
+		if err := database.DB.Model(user).Updates(updates).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Failed to update user",
+			})
+		}
+	}
+
+	if revokeSessions {
+		if err := utils.RevokeUserSessions(user.ID); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Updated user but failed to revoke existing sessions",
+			})
+		}
+	}
+
+	if err := database.DB.First(user, user.ID).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to reload user",
+		})
+	}
+	return c.JSON(user)
+}
+
+// DeleteUser handles DELETE /admin/users/:id
+func DeleteUser(c *fiber.Ctx) error {
+	user := new(models.User)
+	if err := database.DB.First(user, c.Params("id")).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+
+	if err := database.DB.Delete(user).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to delete user",
+		})
+	}
+
+	if err := utils.RevokeUserSessions(user.ID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Deleted user but failed to revoke existing sessions",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "User deleted",
+	})
+}
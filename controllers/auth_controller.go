@@ -0,0 +1,259 @@
+// Package controllers holds the Fiber route handlers for the app.
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"github.com/golu7059/Go-Fiber-auth/database"
+	"github.com/golu7059/Go-Fiber-auth/models"
+	"github.com/golu7059/Go-Fiber-auth/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// RegisterUser handles user registration
+func RegisterUser(c *fiber.Ctx) error {
+	// Parse request body
+	input := new(models.RegisterRequest)
+	if err := c.BodyParser(input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body",
+		})
+	}
+
+	// Validate input
+	if fieldErrors := utils.ValidateRequest(input); fieldErrors != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"errors": fieldErrors,
+		})
+	}
+
+	// Role is never client-supplied: the first user ever registered becomes
+	// admin, everyone else starts as a plain user.
+	var userCount int64
+	if err := database.DB.Model(&models.User{}).Count(&userCount).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to check existing users",
+		})
+	}
+	role := "user"
+	if userCount == 0 {
+		role = "admin"
+	}
+
+	// Hash password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to hash password",
+		})
+	}
+
+	user := &models.User{
+		Name:     input.Name,
+		Email:    input.Email,
+		Password: string(hashedPassword),
+		Role:     role,
+	}
+
+	// Save user to database
+	if err := database.DB.Create(user).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to save user to database",
+		})
+	}
+
+	if err := sendVerificationEmail(user); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "User registered but failed to send verification email",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "User registered successfully, please verify your email",
+	})
+}
+
+// LoginUser handles user login and, on success, issues a JWT access/refresh pair
+func LoginUser(c *fiber.Ctx) error {
+	// Parse request body
+	input := new(models.LoginRequest)
+	if err := c.BodyParser(input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body",
+		})
+	}
+
+	// Validate input
+	if fieldErrors := utils.ValidateRequest(input); fieldErrors != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"errors": fieldErrors,
+		})
+	}
+
+	// Find user by email
+	user := new(models.User)
+	if err := database.DB.Where("email = ?", input.Email).First(user).Error; err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Invalid email or password",
+		})
+	}
+
+	// Compare passwords
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Invalid email or password",
+		})
+	}
+
+	if config.App.RequireEmailVerification && !user.Verified {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "Please verify your email before logging in",
+		})
+	}
+
+	if user.Disabled {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "This account has been disabled",
+		})
+	}
+
+	td, err := utils.CreateToken(user.ID, user.Role)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to issue tokens",
+		})
+	}
+
+	if err := utils.SaveTokenMetadata(user.ID, td); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to persist session",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message":       "Login successful",
+		"access_token":  td.AccessToken,
+		"refresh_token": td.RefreshToken,
+	})
+}
+
+// RefreshToken exchanges a valid, unrevoked refresh token for a brand new
+// access/refresh pair, deleting the old refresh token so it cannot be reused.
+func RefreshToken(c *fiber.Ctx) error {
+	input := struct {
+		RefreshToken string `json:"refresh_token"`
+	}{}
+	if err := c.BodyParser(&input); err != nil || input.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "refresh_token is required",
+		})
+	}
+
+	claims, err := utils.ValidateRefreshToken(input.RefreshToken)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Invalid or expired refresh token",
+		})
+	}
+
+	userID, err := utils.RedisClient.Get(utils.Ctx, claims.TokenUUID).Result()
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Refresh token has been revoked",
+		})
+	}
+
+	// Refresh tokens are single-use: delete the old one before issuing a new pair
+	if err := utils.RedisClient.Del(utils.Ctx, claims.TokenUUID).Err(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to revoke previous session",
+		})
+	}
+
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Corrupt session state",
+		})
+	}
+
+	// Re-fetch the user so a role change or disablement since the refresh
+	// token was issued takes effect on the new access token.
+	user := new(models.User)
+	if err := database.DB.First(user, uint(id)).Error; err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "User no longer exists",
+		})
+	}
+	if user.Disabled {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "This account has been disabled",
+		})
+	}
+
+	td, err := utils.CreateToken(user.ID, user.Role)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to issue tokens",
+		})
+	}
+
+	if err := utils.SaveTokenMetadata(user.ID, td); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to persist session",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"access_token":  td.AccessToken,
+		"refresh_token": td.RefreshToken,
+	})
+}
+
+// LogoutUser revokes the access token used to authenticate this request and,
+// if a refresh token is supplied, revokes that too so both halves of the
+// session die together.
+func LogoutUser(c *fiber.Ctx) error {
+	accessUUID, ok := c.Locals("access_uuid").(string)
+	if ok && accessUUID != "" {
+		utils.RedisClient.Del(utils.Ctx, accessUUID)
+	}
+
+	input := struct {
+		RefreshToken string `json:"refresh_token"`
+	}{}
+	if err := c.BodyParser(&input); err == nil && input.RefreshToken != "" {
+		if claims, err := utils.ValidateRefreshToken(input.RefreshToken); err == nil {
+			utils.RedisClient.Del(utils.Ctx, claims.TokenUUID)
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Logged out successfully",
+	})
+}
+
+// Me returns the profile of the currently authenticated user
+func Me(c *fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uint)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Not authenticated",
+		})
+	}
+
+	user := new(models.User)
+	if err := database.DB.First(user, userID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"id":    user.ID,
+		"name":  user.Name,
+		"email": user.Email,
+	})
+}
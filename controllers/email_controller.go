@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"github.com/golu7059/Go-Fiber-auth/database"
+	"github.com/golu7059/Go-Fiber-auth/models"
+	"github.com/golu7059/Go-Fiber-auth/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	verificationTokenTTL = 24 * time.Hour
+	passwordResetTTL     = 1 * time.Hour
+)
+
+// sendVerificationEmail issues a single-use verification token for user and
+// emails a link the user can follow to confirm their address.
+func sendVerificationEmail(user *models.User) error {
+	token, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return err
+	}
+
+	record := &models.VerificationToken{
+		UserID:    user.ID,
+		Token:     token,
+		ExpiresAt: time.Now().Add(verificationTokenTTL),
+	}
+	if err := database.DB.Create(record).Error; err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify?token=%s", config.App.AppBaseURL, token)
+	body := fmt.Sprintf("Hi %s,\n\nVerify your email by visiting: %s\n\nThis link expires in 24 hours.", user.Name, link)
+	return utils.Mailer.Send(user.Email, "Verify your email", body)
+}
+
+// VerifyEmail handles GET /verify?token=... and marks the owning user's
+// account as verified if the token is present and not expired.
+func VerifyEmail(c *fiber.Ctx) error {
+	token := c.Query("token")
+	if token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "token is required",
+		})
+	}
+
+	record := new(models.VerificationToken)
+	if err := database.DB.Where("token = ? AND used_at IS NULL", token).First(record).Error; err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid or already used verification token",
+		})
+	}
+
+	if time.Now().After(record.ExpiresAt) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Verification token has expired",
+		})
+	}
+
+	now := time.Now()
+	if err := database.DB.Model(&models.User{}).Where("id = ?", record.UserID).
+		Updates(map[string]any{"verified": true, "verified_at": now}).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to verify email",
+		})
+	}
+	record.UsedAt = &now
+	database.DB.Save(record)
+
+	return c.JSON(fiber.Map{
+		"message": "Email verified successfully",
+	})
+}
+
+// ForgotPassword handles POST /password/forgot. It always responds with the
+// same success message regardless of whether the email exists, to avoid
+// leaking account existence.
+func ForgotPassword(c *fiber.Ctx) error {
+	input := struct {
+		Email string `json:"email" validate:"required,email"`
+	}{}
+	if err := c.BodyParser(&input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body",
+		})
+	}
+	if fieldErrors := utils.ValidateRequest(input); fieldErrors != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"errors": fieldErrors,
+		})
+	}
+
+	user := new(models.User)
+	if err := database.DB.Where("email = ?", input.Email).First(user).Error; err == nil {
+		token, err := utils.GenerateSecureToken(32)
+		if err == nil {
+			record := &models.PasswordResetToken{
+				UserID:    user.ID,
+				Token:     token,
+				ExpiresAt: time.Now().Add(passwordResetTTL),
+			}
+			if err := database.DB.Create(record).Error; err == nil {
+				link := fmt.Sprintf("%s/password/reset?token=%s", config.App.AppBaseURL, token)
+				body := fmt.Sprintf("Hi %s,\n\nReset your password by visiting: %s\n\nThis link expires in 1 hour.", user.Name, link)
+				utils.Mailer.Send(user.Email, "Reset your password", body)
+			}
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "If that email is registered, a reset link has been sent",
+	})
+}
+
+// ResetPassword handles POST /password/reset, consuming a single-use reset
+// token and replacing the owning user's password.
+func ResetPassword(c *fiber.Ctx) error {
+	input := struct {
+		Token    string `json:"token" validate:"required"`
+		Password string `json:"password" validate:"required,password"`
+	}{}
+	if err := c.BodyParser(&input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body",
+		})
+	}
+	if fieldErrors := utils.ValidateRequest(input); fieldErrors != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"errors": fieldErrors,
+		})
+	}
+
+	record := new(models.PasswordResetToken)
+	if err := database.DB.Where("token = ? AND used_at IS NULL", input.Token).First(record).Error; err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid or already used reset token",
+		})
+	}
+
+	if time.Now().After(record.ExpiresAt) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Reset token has expired",
+		})
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to hash password",
+		})
+	}
+
+	if err := database.DB.Model(&models.User{}).Where("id = ?", record.UserID).
+		Update("password", string(hashedPassword)).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to reset password",
+		})
+	}
+
+	now := time.Now()
+	record.UsedAt = &now
+	database.DB.Save(record)
+
+	return c.JSON(fiber.Map{
+		"message": "Password reset successfully",
+	})
+}
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"github.com/golu7059/Go-Fiber-auth/database"
+	"github.com/golu7059/Go-Fiber-auth/models"
+	"github.com/golu7059/Go-Fiber-auth/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrOAuthEmailConflict is returned by upsertOAuthUser when the provider
+// profile's email belongs to an existing account that isn't safe to
+// auto-link (see upsertOAuthUser for why).
+var ErrOAuthEmailConflict = errors.New("email already registered to another account")
+
+const (
+	oauthStateCookie = "oauth_state"
+	oauthStateTTL    = 5 * time.Minute
+)
+
+// OAuthLogin redirects the client to the given provider's consent screen,
+// stashing a signed CSRF state nonce in a short-lived cookie.
+func OAuthLogin(c *fiber.Ctx) error {
+	provider, ok := utils.OAuthProviders[c.Params("provider")]
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Unknown OAuth provider",
+		})
+	}
+
+	nonce, signedState, err := utils.NewSignedState(config.App.OAuthStateSecret)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to start OAuth flow",
+		})
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     oauthStateCookie,
+		Value:    signedState,
+		Expires:  time.Now().Add(oauthStateTTL),
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+
+	return c.Redirect(provider.Config.AuthCodeURL(nonce))
+}
+
+// OAuthCallback exchanges the authorization code, fetches the provider
+// profile, upserts the local user, and issues the same JWT pair the
+// password login flow issues.
+func OAuthCallback(c *fiber.Ctx) error {
+	providerName := c.Params("provider")
+	provider, ok := utils.OAuthProviders[providerName]
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Unknown OAuth provider",
+		})
+	}
+
+	state := c.Query("state")
+	signedState := c.Cookies(oauthStateCookie)
+	c.ClearCookie(oauthStateCookie)
+	if state == "" || signedState == "" || !utils.VerifySignedState(config.App.OAuthStateSecret, signedState, state) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid OAuth state",
+		})
+	}
+
+	code := c.Query("code")
+	if code == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Missing authorization code",
+		})
+	}
+
+	token, err := provider.Config.Exchange(utils.Ctx, code)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Failed to exchange authorization code",
+		})
+	}
+
+	profile, err := utils.FetchProfile(provider, token)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Failed to fetch OAuth profile",
+		})
+	}
+
+	user, err := upsertOAuthUser(providerName, profile)
+	if errors.Is(err, ErrOAuthEmailConflict) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "This email is already registered. Log in with your password and link this provider from account settings.",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to link OAuth account",
+		})
+	}
+
+	if user.Disabled {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "This account has been disabled",
+		})
+	}
+
+	td, err := utils.CreateToken(user.ID, user.Role)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to issue tokens",
+		})
+	}
+	if err := utils.SaveTokenMetadata(user.ID, td); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to persist session",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message":       "Login successful",
+		"access_token":  td.AccessToken,
+		"refresh_token": td.RefreshToken,
+	})
+}
+
+// upsertOAuthUser finds the user already linked to this provider identity,
+// links an existing account with a matching email, or creates a brand new
+// user, returning the user in all three cases.
+//
+// Auto-linking by email is only safe when both sides vouch for it: the
+// provider must report the email as verified, and the existing local account
+// must itself be verified. Otherwise an attacker could pre-register a
+// password account under the victim's email address and silently inherit
+// the victim's account the first time they sign in with that provider.
+func upsertOAuthUser(provider string, profile *utils.OAuthProfile) (*models.User, error) {
+	identity := new(models.OAuthIdentity)
+	if err := database.DB.Where("provider = ? AND provider_user_id = ?", provider, profile.ProviderUserID).First(identity).Error; err == nil {
+		user := new(models.User)
+		if err := database.DB.First(user, identity.UserID).Error; err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
+	if profile.Email == "" {
+		return nil, fmt.Errorf("%s did not return a usable email address", provider)
+	}
+
+	existing := new(models.User)
+	if err := database.DB.Where("email = ?", profile.Email).First(existing).Error; err == nil {
+		if !profile.EmailVerified || !existing.Verified {
+			return nil, ErrOAuthEmailConflict
+		}
+		return linkIdentity(provider, profile, existing)
+	}
+
+	newUser, err := createOAuthUser(provider, profile)
+	if err != nil {
+		return nil, err
+	}
+	return linkIdentity(provider, profile, newUser)
+}
+
+// linkIdentity records the provider identity against user and returns it.
+func linkIdentity(provider string, profile *utils.OAuthProfile, user *models.User) (*models.User, error) {
+	newIdentity := &models.OAuthIdentity{
+		Provider:       provider,
+		ProviderUserID: profile.ProviderUserID,
+		UserID:         user.ID,
+	}
+	if err := database.DB.Create(newIdentity).Error; err != nil {
+		return nil, fmt.Errorf("failed to link oauth identity: %w", err)
+	}
+	return user, nil
+}
+
+// createOAuthUser provisions a local account for a first-time social login.
+// The password field is never used for sign-in, but it's populated with a
+// random hash so the column's NOT NULL / validation constraints still hold.
+func createOAuthUser(provider string, profile *utils.OAuthProfile) (*models.User, error) {
+	randomPassword, err := utils.GenerateSecureToken(16)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(randomPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	var userCount int64
+	if err := database.DB.Model(&models.User{}).Count(&userCount).Error; err != nil {
+		return nil, err
+	}
+	role := "user"
+	if userCount == 0 {
+		role = "admin"
+	}
+
+	now := time.Now()
+	user := &models.User{
+		Name:         profile.Name,
+		Email:        profile.Email,
+		Password:     string(hashedPassword),
+		AuthProvider: provider,
+		Verified:     true,
+		VerifiedAt:   &now,
+		Role:         role,
+	}
+	if err := database.DB.Create(user).Error; err != nil {
+		return nil, err
+	}
+	return user, nil
+}
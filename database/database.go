@@ -0,0 +1,69 @@
+// Package database owns the GORM connection, choosing the underlying driver
+// from configuration so the rest of the app never imports a specific driver.
+package database
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"github.com/golu7059/Go-Fiber-auth/models"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// DB is the process-wide database handle, set up by Connect.
+var DB *gorm.DB
+
+// Connect opens a connection using the driver named by cfg.DBDriver and,
+// when DB_AUTO_MIGRATE is enabled, migrates the schema.
+func Connect(cfg *config.Config) (*gorm.DB, error) {
+	dialector, err := dialectorFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if cfg.DBAutoMigrate {
+		if err := db.AutoMigrate(&models.User{}, &models.VerificationToken{}, &models.PasswordResetToken{}, &models.OAuthIdentity{}); err != nil {
+			return nil, fmt.Errorf("failed to migrate database schema: %w", err)
+		}
+	} else {
+		log.Println("DB_AUTO_MIGRATE is disabled, skipping schema migration")
+	}
+
+	DB = db
+	return db, nil
+}
+
+// dialectorFor builds the GORM dialector for the configured driver.
+func dialectorFor(cfg *config.Config) (gorm.Dialector, error) {
+	switch cfg.DBDriver {
+	case "postgres":
+		dsn := fmt.Sprintf(
+			"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+			cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPass, cfg.DBName, cfg.DBSSLMode,
+		)
+		return postgres.Open(dsn), nil
+	case "mysql":
+		dsn := fmt.Sprintf(
+			"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			cfg.DBUser, cfg.DBPass, cfg.DBHost, cfg.DBPort, cfg.DBName,
+		)
+		return mysql.Open(dsn), nil
+	case "sqlite", "":
+		dsn := cfg.DBName
+		if dsn == "" {
+			dsn = "users.db"
+		}
+		return sqlite.Open(dsn), nil
+	default:
+		return nil, fmt.Errorf("unsupported DB_DRIVER %q", cfg.DBDriver)
+	}
+}
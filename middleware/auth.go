@@ -0,0 +1,42 @@
+// Package middleware contains Fiber middleware shared across route groups.
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golu7059/Go-Fiber-auth/utils"
+)
+
+// DeauthenticateMiddleware verifies the bearer access token on protected
+// routes, confirming both its signature and its presence in Redis, and
+// rejects the request if either check fails (e.g. after a logout).
+func DeauthenticateMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		authHeader := c.Get("Authorization")
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Missing or malformed authorization header",
+			})
+		}
+
+		claims, err := utils.ValidateAccessToken(parts[1])
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Invalid or expired token",
+			})
+		}
+
+		if err := utils.RedisClient.Get(utils.Ctx, claims.TokenUUID).Err(); err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Token has been revoked",
+			})
+		}
+
+		c.Locals("user_id", claims.UserID)
+		c.Locals("access_uuid", claims.TokenUUID)
+		c.Locals("role", claims.Role)
+		return c.Next()
+	}
+}
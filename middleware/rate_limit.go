@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// IPRateLimiter throttles requests by client IP, bounding how many requests
+// a single IP can make regardless of which email it targets. Pair with
+// EmailRateLimiter so both axes are independently bounded.
+func IPRateLimiter() fiber.Handler {
+	return limiter.New(limiter.Config{
+		Max:        5,
+		Expiration: 15 * time.Minute,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			return c.IP()
+		},
+	})
+}
+
+// EmailRateLimiter throttles requests by the "email" field in the JSON body,
+// bounding how many requests a single victim's email can receive regardless
+// of how many source IPs they come from. Pair with IPRateLimiter so both
+// axes are independently bounded.
+func EmailRateLimiter() fiber.Handler {
+	return limiter.New(limiter.Config{
+		Max:          5,
+		Expiration:   15 * time.Minute,
+		KeyGenerator: emailFromBody,
+	})
+}
+
+// emailFromBody best-effort extracts the "email" field from a JSON request
+// body without consuming it, so the handler can still parse the body later.
+func emailFromBody(c *fiber.Ctx) string {
+	var payload struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(c.Body(), &payload); err != nil {
+		return ""
+	}
+	return payload.Email
+}
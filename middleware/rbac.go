@@ -0,0 +1,20 @@
+package middleware
+
+import "github.com/gofiber/fiber/v2"
+
+// RequireRole rejects the request with 403 unless the JWT role claim set by
+// DeauthenticateMiddleware matches one of the given roles. It must run after
+// DeauthenticateMiddleware in the chain.
+func RequireRole(roles ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		role, _ := c.Locals("role").(string)
+		for _, allowed := range roles {
+			if role == allowed {
+				return c.Next()
+			}
+		}
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "Insufficient permissions",
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package models
+
+// LoginRequest is the payload accepted by POST /login.
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+// RegisterRequest is the payload accepted by POST /register. It mirrors the
+// client-settable fields of User, but keeps Password readable here since
+// User.Password is tagged json:"-" to keep the hash out of API responses.
+type RegisterRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,password"`
+}
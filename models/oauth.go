@@ -0,0 +1,11 @@
+package models
+
+// OAuthIdentity links a social login identity to a local user, so the same
+// person can sign in via multiple providers (or password login) and always
+// land on the same account.
+type OAuthIdentity struct {
+	ID             uint   `gorm:"primaryKey"`
+	Provider       string `gorm:"uniqueIndex:idx_provider_user"`
+	ProviderUserID string `gorm:"uniqueIndex:idx_provider_user"`
+	UserID         uint   `gorm:"index"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// VerificationToken is a single-use, expiring token sent to a user's email
+// address so they can confirm ownership of it.
+type VerificationToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	Token     string `gorm:"uniqueIndex"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// PasswordResetToken is a single-use, expiring token that authorizes one
+// password reset for the user it was issued to.
+type PasswordResetToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	Token     string `gorm:"uniqueIndex"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
@@ -0,0 +1,17 @@
+// Package models contains the GORM-backed data models shared across the app.
+package models
+
+import "time"
+
+// User represents the user model for the database
+type User struct {
+	ID           uint       `gorm:"primaryKey"`
+	Name         string     `json:"name" validate:"required"`
+	Email        string     `json:"email" gorm:"unique" validate:"required,email"`
+	Password     string     `json:"-" validate:"required,password"`
+	Verified     bool       `json:"verified" gorm:"default:false"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	AuthProvider string     `json:"auth_provider" gorm:"default:local"`
+	Role         string     `json:"role" gorm:"default:user"`
+	Disabled     bool       `json:"disabled" gorm:"default:false"`
+}
@@ -0,0 +1,31 @@
+// Package routes wires controllers and middleware onto the Fiber app.
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golu7059/Go-Fiber-auth/controllers"
+	"github.com/golu7059/Go-Fiber-auth/middleware"
+)
+
+// Setup registers every route the app exposes.
+func Setup(app *fiber.App) {
+	app.Post("/register", controllers.RegisterUser)
+	app.Post("/login", controllers.LoginUser)
+	app.Post("/refresh", controllers.RefreshToken)
+	app.Get("/verify", controllers.VerifyEmail)
+	app.Post("/password/forgot", middleware.IPRateLimiter(), middleware.EmailRateLimiter(), controllers.ForgotPassword)
+	app.Post("/password/reset", middleware.IPRateLimiter(), middleware.EmailRateLimiter(), controllers.ResetPassword)
+	app.Get("/auth/:provider/login", controllers.OAuthLogin)
+	app.Get("/auth/:provider/callback", controllers.OAuthCallback)
+
+	// Protected routes
+	app.Post("/logout", middleware.DeauthenticateMiddleware(), controllers.LogoutUser)
+	app.Get("/me", middleware.DeauthenticateMiddleware(), controllers.Me)
+
+	// Admin-only routes
+	admin := app.Group("/admin", middleware.DeauthenticateMiddleware(), middleware.RequireRole("admin"))
+	admin.Get("/users", controllers.ListUsers)
+	admin.Get("/users/:id", controllers.GetUser)
+	admin.Patch("/users/:id", controllers.UpdateUser)
+	admin.Delete("/users/:id", controllers.DeleteUser)
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"fmt"
+	"log"
+	"net/smtp"
+
+	"github.com/golu7059/Go-Fiber-auth/config"
+)
+
+// EmailSender dispatches a single email. It's an interface so tests (and
+// local development without SMTP credentials) can swap in a no-op implementation.
+type EmailSender interface {
+	Send(to, subject, body string) error
+}
+
+// Mailer is the process-wide EmailSender, set up by InitMailer.
+var Mailer EmailSender
+
+// InitMailer picks an EmailSender based on cfg: a real SMTP sender when
+// SMTP_HOST is configured, otherwise one that just logs the message.
+func InitMailer(cfg *config.Config) {
+	if cfg.SMTPHost == "" {
+		Mailer = &LogEmailSender{}
+		return
+	}
+	Mailer = &SMTPEmailSender{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.SMTPFrom,
+	}
+}
+
+// SMTPEmailSender sends email through a standard SMTP relay.
+type SMTPEmailSender struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// Send implements EmailSender.
+func (s *SMTPEmailSender) Send(to, subject, body string) error {
+	addr := fmt.Sprintf("%s:%s", s.Host, s.Port)
+	auth := smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	msg := []byte(fmt.Sprintf("To: %s\r\nSubject: %s\r\n\r\n%s", to, subject, body))
+	return smtp.SendMail(addr, auth, s.From, []string{to}, msg)
+}
+
+// LogEmailSender just logs the message instead of sending it, for local
+// development and tests where no SMTP relay is configured.
+type LogEmailSender struct{}
+
+// Send implements EmailSender.
+func (l *LogEmailSender) Send(to, subject, body string) error {
+	log.Printf("[email] to=%s subject=%s body=%s", to, subject, body)
+	return nil
+}
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+	"golang.org/x/oauth2/google"
+)
+
+// OAuthProfile is the subset of a provider's userinfo response we care about.
+// EmailVerified reflects the provider's own verification of Email, not our
+// local Verified flag — callers must still treat it as untrusted input from
+// a third party, just one the provider vouches for.
+type OAuthProfile struct {
+	ProviderUserID string
+	Email          string
+	EmailVerified  bool
+	Name           string
+}
+
+// OAuthProvider bundles an oauth2.Config with how to fetch that provider's
+// profile. fetchProfile receives a client pre-authorized with the exchanged
+// token so providers that need more than one API call (GitHub's separate
+// emails endpoint) can make them.
+type OAuthProvider struct {
+	Config       *oauth2.Config
+	fetchProfile func(*http.Client) (*OAuthProfile, error)
+}
+
+// OAuthProviders holds the configured providers, keyed by name (e.g. "google", "github").
+// A provider is only registered when its client ID is set.
+var OAuthProviders = map[string]*OAuthProvider{}
+
+// InitOAuthProviders builds the OAuthProviders map from cfg.
+func InitOAuthProviders(cfg *config.Config) {
+	OAuthProviders = map[string]*OAuthProvider{}
+
+	if cfg.GoogleClientID != "" {
+		OAuthProviders["google"] = &OAuthProvider{
+			Config: &oauth2.Config{
+				ClientID:     cfg.GoogleClientID,
+				ClientSecret: cfg.GoogleClientSecret,
+				RedirectURL:  cfg.GoogleRedirectURL,
+				Scopes:       []string{"openid", "email", "profile"},
+				Endpoint:     google.Endpoint,
+			},
+			fetchProfile: fetchGoogleProfile,
+		}
+	}
+
+	if cfg.GithubClientID != "" {
+		OAuthProviders["github"] = &OAuthProvider{
+			Config: &oauth2.Config{
+				ClientID:     cfg.GithubClientID,
+				ClientSecret: cfg.GithubClientSecret,
+				RedirectURL:  cfg.GithubRedirectURL,
+				Scopes:       []string{"read:user", "user:email"},
+				Endpoint:     github.Endpoint,
+			},
+			fetchProfile: fetchGitHubProfile,
+		}
+	}
+}
+
+// FetchProfile exchanges the given access token for the provider's userinfo
+// and normalizes it into an OAuthProfile.
+func FetchProfile(provider *OAuthProvider, token *oauth2.Token) (*OAuthProfile, error) {
+	client := provider.Config.Client(Ctx, token)
+	return provider.fetchProfile(client)
+}
+
+func getJSON(client *http.Client, url string, out interface{}) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oauth request to %s failed: %s", url, resp.Status)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+func fetchGoogleProfile(client *http.Client) (*OAuthProfile, error) {
+	var payload struct {
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := getJSON(client, "https://www.googleapis.com/oauth2/v3/userinfo", &payload); err != nil {
+		return nil, err
+	}
+	return &OAuthProfile{
+		ProviderUserID: payload.Sub,
+		Email:          payload.Email,
+		EmailVerified:  payload.EmailVerified,
+		Name:           payload.Name,
+	}, nil
+}
+
+// fetchGitHubProfile fetches /user for the name and ID, then /user/emails for
+// the primary address and whether GitHub has verified it (falling back to the
+// first verified address when none is marked primary; an unverified primary
+// is still returned, with EmailVerified false). GitHub omits email from /user entirely when
+// the user has made it private, so the emails endpoint is the only reliable
+// source — and the only one we trust enough to auto-link an existing account.
+func fetchGitHubProfile(client *http.Client) (*OAuthProfile, error) {
+	var user struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name"`
+		Login string `json:"login"`
+	}
+	if err := getJSON(client, "https://api.github.com/user", &user); err != nil {
+		return nil, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(client, "https://api.github.com/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	name := user.Name
+	if name == "" {
+		name = user.Login
+	}
+
+	var email string
+	var verified bool
+	for _, e := range emails {
+		if e.Primary {
+			email, verified = e.Email, e.Verified
+			break
+		}
+	}
+	if email == "" {
+		for _, e := range emails {
+			if e.Verified {
+				email, verified = e.Email, true
+				break
+			}
+		}
+	}
+
+	return &OAuthProfile{
+		ProviderUserID: fmt.Sprintf("%d", user.ID),
+		Email:          email,
+		EmailVerified:  verified,
+		Name:           name,
+	}, nil
+}
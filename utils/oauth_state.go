@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// NewSignedState generates a random nonce for the OAuth "state" parameter
+// along with a signed cookie value binding that nonce to this server, so the
+// callback can detect a forged or replayed state.
+func NewSignedState(secret string) (nonce, cookieValue string, err error) {
+	nonce, err = GenerateSecureToken(16)
+	if err != nil {
+		return "", "", err
+	}
+	return nonce, nonce + "." + signState(secret, nonce), nil
+}
+
+// VerifySignedState checks that cookieValue was produced by NewSignedState
+// for secret and that it binds to receivedState.
+func VerifySignedState(secret, cookieValue, receivedState string) bool {
+	nonce, sig, ok := strings.Cut(cookieValue, ".")
+	if !ok {
+		return false
+	}
+	if !hmac.Equal([]byte(sig), []byte(signState(secret, nonce))) {
+		return false
+	}
+	return nonce == receivedState
+}
+
+func signState(secret, nonce string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(nonce))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"context"
+	"log"
+
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// Ctx is the background context used for the short-lived Redis calls in this package.
+var Ctx = context.Background()
+
+// RedisClient is the process-wide Redis client used to track issued token UUIDs.
+var RedisClient *redis.Client
+
+// InitRedis connects to the Redis instance described by cfg.
+func InitRedis(cfg *config.Config) {
+	RedisClient = redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisAddr,
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+
+	if err := RedisClient.Ping(Ctx).Err(); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+}
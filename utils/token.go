@@ -0,0 +1,234 @@
+// Package utils holds the JWT and Redis session helpers shared by the
+// controllers and middleware packages.
+package utils
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golu7059/Go-Fiber-auth/config"
+	"github.com/google/uuid"
+)
+
+// TokenDetails holds the signed JWT pair along with the random identifiers
+// that are persisted in Redis so either token can be revoked on demand.
+type TokenDetails struct {
+	AccessToken  string
+	RefreshToken string
+	AccessUUID   string
+	RefreshUUID  string
+	AtExpires    int64
+	RtExpires    int64
+}
+
+// AccessDetails is the minimal set of claims callers need after validating a token.
+type AccessDetails struct {
+	TokenUUID string
+	UserID    uint
+	Role      string
+}
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// Token type claims distinguishing access tokens from refresh tokens, so a
+// token minted for one purpose can't be replayed as the other (e.g. a leaked
+// refresh token used as a Bearer access token, or an access token exchanged
+// at /refresh for a fresh week-long refresh token).
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+var (
+	jwtPrivateKey *rsa.PrivateKey
+	jwtPublicKey  *rsa.PublicKey
+)
+
+// InitJWTKeys loads the RSA key pair used to sign and verify access/refresh
+// tokens. JWTPrivateKey/JWTPublicKey may either hold the PEM contents
+// directly or a path to a file containing them.
+func InitJWTKeys(cfg *config.Config) error {
+	privatePEM, err := loadPEM(cfg.JWTPrivateKey, "JWT_PRIVATE_KEY")
+	if err != nil {
+		return err
+	}
+	jwtPrivateKey, err = jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWT private key: %w", err)
+	}
+
+	publicPEM, err := loadPEM(cfg.JWTPublicKey, "JWT_PUBLIC_KEY")
+	if err != nil {
+		return err
+	}
+	jwtPublicKey, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWT public key: %w", err)
+	}
+
+	return nil
+}
+
+// loadPEM treats val as a file path when it points to an existing file,
+// otherwise as the raw PEM content itself.
+func loadPEM(val, envVar string) ([]byte, error) {
+	if val == "" {
+		return nil, fmt.Errorf("%s is not set", envVar)
+	}
+	if data, err := os.ReadFile(val); err == nil {
+		return data, nil
+	}
+	return []byte(val), nil
+}
+
+// CreateToken generates a new access/refresh JWT pair for the given user,
+// each carrying its own random token_uuid claim plus the user's role so
+// RequireRole can authorize requests without a database round trip.
+func CreateToken(userID uint, role string) (*TokenDetails, error) {
+	td := &TokenDetails{
+		AtExpires:   time.Now().Add(accessTokenTTL).Unix(),
+		AccessUUID:  uuid.NewString(),
+		RtExpires:   time.Now().Add(refreshTokenTTL).Unix(),
+		RefreshUUID: uuid.NewString(),
+	}
+
+	accessToken, err := signToken(userID, role, td.AccessUUID, tokenTypeAccess, td.AtExpires)
+	if err != nil {
+		return nil, err
+	}
+	td.AccessToken = accessToken
+
+	refreshToken, err := signToken(userID, role, td.RefreshUUID, tokenTypeRefresh, td.RtExpires)
+	if err != nil {
+		return nil, err
+	}
+	td.RefreshToken = refreshToken
+
+	return td, nil
+}
+
+// signToken produces an RS256-signed JWT embedding sub, role, token_uuid,
+// token_type, iat and exp.
+func signToken(userID uint, role, tokenUUID, tokenType string, expires int64) (string, error) {
+	claims := jwt.MapClaims{
+		"sub":        userID,
+		"role":       role,
+		"token_uuid": tokenUUID,
+		"token_type": tokenType,
+		"iat":        time.Now().Unix(),
+		"exp":        expires,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	return token.SignedString(jwtPrivateKey)
+}
+
+// userSessionsKey is the Redis set tracking every access and refresh
+// token_uuid issued to a user, so all of their sessions can be revoked at
+// once.
+func userSessionsKey(userID uint) string {
+	return fmt.Sprintf("user:%d:sessions", userID)
+}
+
+// SaveTokenMetadata persists the token_uuid -> user_id mapping in Redis with a
+// TTL matching each token's lifetime, enabling immediate revocation on logout,
+// and records both token_uuids against the user so RevokeUserSessions can
+// find and delete them later — including the access token, so a role change
+// or disablement takes effect immediately instead of waiting out its TTL.
+func SaveTokenMetadata(userID uint, td *TokenDetails) error {
+	now := time.Now()
+	id := strconv.FormatUint(uint64(userID), 10)
+
+	if err := RedisClient.Set(Ctx, td.AccessUUID, id, time.Unix(td.AtExpires, 0).Sub(now)).Err(); err != nil {
+		return err
+	}
+	if err := RedisClient.Set(Ctx, td.RefreshUUID, id, time.Unix(td.RtExpires, 0).Sub(now)).Err(); err != nil {
+		return err
+	}
+
+	sessionsKey := userSessionsKey(userID)
+	if err := RedisClient.SAdd(Ctx, sessionsKey, td.AccessUUID, td.RefreshUUID).Err(); err != nil {
+		return err
+	}
+	return RedisClient.Expire(Ctx, sessionsKey, refreshTokenTTL).Err()
+}
+
+// RevokeUserSessions deletes every access and refresh token_uuid on record
+// for userID, immediately invalidating their existing sessions (used when an
+// admin changes a user's role or disables their account).
+func RevokeUserSessions(userID uint) error {
+	sessionsKey := userSessionsKey(userID)
+	uuids, err := RedisClient.SMembers(Ctx, sessionsKey).Result()
+	if err != nil {
+		return err
+	}
+	for _, tokenUUID := range uuids {
+		RedisClient.Del(Ctx, tokenUUID)
+	}
+	return RedisClient.Del(Ctx, sessionsKey).Err()
+}
+
+// ValidateAccessToken parses and verifies a JWT against the RSA public key,
+// extracts its claims, and rejects it unless it was issued as an access
+// token — otherwise a leaked refresh token could be replayed as a Bearer
+// access token for up to its full 7-day lifetime.
+func ValidateAccessToken(tokenString string) (*AccessDetails, error) {
+	return validateToken(tokenString, tokenTypeAccess)
+}
+
+// ValidateRefreshToken parses and verifies a JWT against the RSA public key,
+// extracts its claims, and rejects it unless it was issued as a refresh
+// token — otherwise a short-lived access token could be exchanged at
+// /refresh for a fresh, much longer-lived refresh token.
+func ValidateRefreshToken(tokenString string) (*AccessDetails, error) {
+	return validateToken(tokenString, tokenTypeRefresh)
+}
+
+// validateToken parses and verifies a JWT against the RSA public key,
+// extracts its token_uuid and user id claims, and checks that its token_type
+// claim matches expectedType.
+func validateToken(tokenString, expectedType string) (*AccessDetails, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return jwtPublicKey, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	tokenType, _ := claims["token_type"].(string)
+	if tokenType != expectedType {
+		return nil, fmt.Errorf("expected a %s token, got %q", expectedType, tokenType)
+	}
+
+	tokenUUID, ok := claims["token_uuid"].(string)
+	if !ok {
+		return nil, fmt.Errorf("token missing token_uuid claim")
+	}
+
+	sub, ok := claims["sub"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("token missing sub claim")
+	}
+
+	role, _ := claims["role"].(string)
+
+	return &AccessDetails{
+		TokenUUID: tokenUUID,
+		UserID:    uint(sub),
+		Role:      role,
+	}, nil
+}
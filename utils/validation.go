@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = newValidator()
+
+// FieldError is a single, structured validation failure suitable for
+// returning directly to API clients.
+type FieldError struct {
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+}
+
+// ValidateRequest runs struct tag validation against v and returns one
+// FieldError per failing rule, or nil if v is valid.
+func ValidateRequest(v any) []FieldError {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []FieldError{{Message: err.Error()}}
+	}
+
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fe.Field(),
+			Tag:     fe.Tag(),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+	return fieldErrors
+}
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	if err := v.RegisterValidation("password", passwordStrength); err != nil {
+		panic(fmt.Sprintf("failed to register password validator: %v", err))
+	}
+	return v
+}
+
+// fieldErrorMessage turns a validator.FieldError into a human-readable message.
+func fieldErrorMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", fe.Field())
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", fe.Field())
+	case "min":
+		return fmt.Sprintf("%s must be at least %s characters", fe.Field(), fe.Param())
+	case "password":
+		return fmt.Sprintf("%s must be at least 8 characters and include an uppercase letter, a lowercase letter, a digit, and a symbol", fe.Field())
+	default:
+		return fmt.Sprintf("%s is invalid", fe.Field())
+	}
+}
+
+const passwordSymbols = "!@#$%^&*()_+-=[]{}|;:,.<>?"
+
+// passwordStrength requires at least 8 characters with at least one
+// uppercase letter, one lowercase letter, one digit, and one symbol.
+func passwordStrength(fl validator.FieldLevel) bool {
+	password := fl.Field().String()
+	if len(password) < 8 {
+		return false
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case strings.ContainsRune(passwordSymbols, r):
+			hasSymbol = true
+		}
+	}
+
+	return hasUpper && hasLower && hasDigit && hasSymbol
+}
@@ -0,0 +1,57 @@
+package utils
+
+import "testing"
+
+type validationFixture struct {
+	Name     string `validate:"required"`
+	Email    string `validate:"required,email"`
+	Password string `validate:"required,password"`
+}
+
+func TestValidateRequest_Valid(t *testing.T) {
+	v := validationFixture{Name: "Ada", Email: "ada@example.com", Password: "Str0ng!Pass"}
+	if errs := ValidateRequest(v); errs != nil {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateRequest_RequiredFields(t *testing.T) {
+	errs := ValidateRequest(validationFixture{})
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidateRequest_InvalidEmail(t *testing.T) {
+	v := validationFixture{Name: "Ada", Email: "not-an-email", Password: "Str0ng!Pass"}
+	errs := ValidateRequest(v)
+	if len(errs) != 1 || errs[0].Tag != "email" {
+		t.Fatalf("expected a single email error, got %v", errs)
+	}
+}
+
+func TestPasswordStrength(t *testing.T) {
+	cases := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{"too short", "A1!aaa", false},
+		{"missing upper", "str0ng!pass", false},
+		{"missing lower", "STR0NG!PASS", false},
+		{"missing digit", "Strong!Pass", false},
+		{"missing symbol", "Str0ngPass", false},
+		{"valid", "Str0ng!Pass", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := validationFixture{Name: "Ada", Email: "ada@example.com", Password: tc.password}
+			errs := ValidateRequest(v)
+			got := errs == nil
+			if got != tc.want {
+				t.Fatalf("password %q: expected valid=%v, got valid=%v (errs=%v)", tc.password, tc.want, got, errs)
+			}
+		})
+	}
+}